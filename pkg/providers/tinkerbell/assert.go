@@ -168,11 +168,18 @@ func AssertHookRetrievableWithoutProxy(spec *ClusterSpec) error {
 	return nil
 }
 
-// AssertPortsNotInUse ensures that ports 80, 42113, and 50061 are available.
-func AssertPortsNotInUse(client networkutils.NetClient) ClusterSpecAssertion {
+// DefaultBootstrapPorts are the ports used by the Tinkerbell stack on the bootstrap host.
+var DefaultBootstrapPorts = []string{"80", "42113", "50061"}
+
+// AssertPortsNotInUse ensures that ports are available. When no ports are provided
+// DefaultBootstrapPorts are checked.
+func AssertPortsNotInUse(client networkutils.NetClient, ports ...string) ClusterSpecAssertion {
+	if len(ports) == 0 {
+		ports = DefaultBootstrapPorts
+	}
 	return func(spec *ClusterSpec) error {
 		host := "0.0.0.0"
-		if err := validatePortsAvailable(client, host); err != nil {
+		if err := validatePortsAvailable(client, host, ports); err != nil {
 			return err
 		}
 		return nil
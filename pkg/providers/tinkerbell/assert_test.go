@@ -237,6 +237,14 @@ func TestK8sVersionInDataCenterOSImageURL_Error(t *testing.T) {
 			c.DatacenterConfig.Spec.OSImageURL = ""
 			c.Cluster.Spec.KubernetesVersion = kube122
 		},
+		"minorVersionPrefixOfURLVersion": func(c *tinkerbell.ClusterSpec) {
+			c.DatacenterConfig.Spec.OSImageURL = "test-url-1-23"
+			c.Cluster.Spec.KubernetesVersion = eksav1alpha1.KubernetesVersion("1.2")
+		},
+		"versionSubstringOfURLDigits": func(c *tinkerbell.ClusterSpec) {
+			c.DatacenterConfig.Spec.OSImageURL = "test-url-1123"
+			c.Cluster.Spec.KubernetesVersion = eksav1alpha1.KubernetesVersion("1.12")
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			cluster := NewDefaultValidClusterSpecBuilder().Build()
@@ -538,6 +546,27 @@ func TestAssertPortsNotInUse_Fails(t *testing.T) {
 	g.Expect(assertion(clusterSpec)).ToNot(gomega.Succeed())
 }
 
+func TestAssertPortsNotInUse_CustomPorts(t *testing.T) {
+	g := gomega.NewWithT(t)
+	ctrl := gomock.NewController(t)
+
+	server, client := net.Pipe()
+	defer server.Close()
+
+	netClient := mocks.NewMockNetClient(ctrl)
+	netClient.EXPECT().
+		DialTimeout("tcp", "0.0.0.0:8080", 500*time.Millisecond).
+		Return(nil, errors.New("failed to connect"))
+	netClient.EXPECT().
+		DialTimeout("tcp", "0.0.0.0:9090", 500*time.Millisecond).
+		Return(client, nil)
+
+	clusterSpec := NewDefaultValidClusterSpecBuilder().Build()
+
+	assertion := tinkerbell.AssertPortsNotInUse(netClient, "8080", "9090")
+	g.Expect(assertion(clusterSpec)).To(gomega.MatchError(gomega.ContainSubstring("[9090]")))
+}
+
 func TestAssertAssertHookImageURLProxyNonAirgappedURLSuccess(t *testing.T) {
 	g := gomega.NewWithT(t)
 
@@ -172,7 +172,7 @@ func (p *Provider) SetupAndValidateCreateCluster(ctx context.Context, clusterSpe
 		HardwareSatisfiesOnlyOneSelectorAssertion(p.catalogue),
 	)
 
-	clusterSpecValidator.Register(AssertPortsNotInUse(p.netClient))
+	clusterSpecValidator.Register(AssertPortsNotInUse(p.netClient, p.BootstrapPorts...))
 
 	if !p.skipIpCheck {
 		clusterSpecValidator.Register(NewIPNotInUseAssertion(p.netClient))
@@ -62,6 +62,9 @@ type Provider struct {
 	tinkerbellIP    string
 	// BMCOptions are Rufio BMC options that are used when creating Rufio machine CRDs.
 	BMCOptions *hardware.BMCOptions
+	// BootstrapPorts are the ports validated as available on the bootstrap host before create.
+	// DefaultBootstrapPorts are used when empty.
+	BootstrapPorts []string
 
 	// TODO(chrisdoheryt4) Temporarily depend on the netclient until the validator can be injected.
 	// This is already a dependency, just uncached, because we require it during the initializing
@@ -3,6 +3,7 @@ package tinkerbell
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 
 	tinkv1alpha1 "github.com/tinkerbell/tink/pkg/apis/core/v1alpha1"
@@ -187,13 +188,19 @@ func defaultBottlerocketOSImageURLs(spec *ClusterSpec) {
 }
 
 func containsK8sVersion(imageURL, k8sVersion string) bool {
-	versionExtractor := strings.NewReplacer("-", "", ".", "", "_", "")
-	osImageURL := versionExtractor.Replace(imageURL)
-	kubeVersion := versionExtractor.Replace(k8sVersion)
+	parts := strings.Split(k8sVersion, ".")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
 	// we set the containsK8sVersion to false if the OS image URL does not contain the specified kubernetes version.
 	// For ex if the kubernetes version is 1.23,
 	// the image url should include 1.23 or 1-23, 1_23 or 123 i.e. ubuntu-1-23.gz or similar in the string.
-	return strings.Contains(osImageURL, kubeVersion)
+	// The version must not be surrounded by other digits so 1.2 doesn't match 1-23 and 1.12 doesn't match 1123.
+	versionMatcher, err := regexp.Compile(`(^|[^0-9])` + strings.Join(parts, `[-._]?`) + `([^0-9]|$)`)
+	if err != nil {
+		return false
+	}
+	return versionMatcher.MatchString(imageURL)
 }
 
 func validateMachineRefExists(
@@ -228,8 +235,8 @@ func validateIPUnused(client networkutils.NetClient, ip string) error {
 	return nil
 }
 
-func validatePortsAvailable(client networkutils.NetClient, host string) error {
-	unavailablePorts := getPortsUnavailable(client, host)
+func validatePortsAvailable(client networkutils.NetClient, host string, ports []string) error {
+	unavailablePorts := getPortsUnavailable(client, host, ports)
 
 	if len(unavailablePorts) != 0 {
 		return fmt.Errorf("localhost ports [%v] are already in use, please ensure these ports are available", strings.Join(unavailablePorts, ", "))
@@ -237,8 +244,7 @@ func validatePortsAvailable(client networkutils.NetClient, host string) error {
 	return nil
 }
 
-func getPortsUnavailable(client networkutils.NetClient, host string) []string {
-	ports := []string{"80", "42113", "50061"}
+func getPortsUnavailable(client networkutils.NetClient, host string, ports []string) []string {
 	var unavailablePorts []string
 	for _, port := range ports {
 		if networkutils.IsPortInUse(client, host, port) {